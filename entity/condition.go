@@ -0,0 +1,80 @@
+package entity
+
+// Operator identifies the comparison used by a leaf Condition node.
+type Operator string
+
+const (
+	OpEq        Operator = "="
+	OpGt        Operator = ">"
+	OpGte       Operator = ">="
+	OpLt        Operator = "<"
+	OpLte       Operator = "<="
+	OpNe        Operator = "<>"
+	OpIn        Operator = "IN"
+	OpNotIn     Operator = "NOT IN"
+	OpBetween   Operator = "BETWEEN"
+	OpLike      Operator = "LIKE"
+	OpILike     Operator = "ILIKE"
+	OpIsNull    Operator = "IS NULL"
+	OpIsNotNull Operator = "IS NOT NULL"
+)
+
+// groupKind identifies how a non-leaf Condition combines its children.
+type groupKind string
+
+const (
+	groupAnd groupKind = "AND"
+	groupOr  groupKind = "OR"
+	groupNot groupKind = "NOT"
+)
+
+// Condition is a node in a WHERE expression tree: either a leaf comparing
+// a field against one or more values, or a group combining child
+// Conditions with AND, OR, or NOT. Build leaves with Field methods (Eq,
+// Gt, In, ...) and compose them with And, Or, and Not. QueryBuilder.Build
+// renders the resulting tree into SQL.
+type Condition struct {
+	field    string
+	op       Operator
+	values   []interface{}
+	group    groupKind
+	children []Condition
+}
+
+func leaf(field string, op Operator, values ...interface{}) Condition {
+	return Condition{field: field, op: op, values: values}
+}
+
+// And combines conditions so that all of them must hold.
+func And(conds ...Condition) Condition {
+	return Condition{group: groupAnd, children: conds}
+}
+
+// Or combines conditions so that at least one of them must hold.
+func Or(conds ...Condition) Condition {
+	return Condition{group: groupOr, children: conds}
+}
+
+// Not negates a condition.
+func Not(cond Condition) Condition {
+	return Condition{group: groupNot, children: []Condition{cond}}
+}
+
+// IsGroup reports whether the condition combines child conditions rather
+// than comparing a field directly.
+func (c Condition) IsGroup() bool { return c.group != "" }
+
+// Group returns "AND", "OR", or "NOT" for a group node.
+func (c Condition) Group() string { return string(c.group) }
+
+// Children returns the nested conditions of a group node.
+func (c Condition) Children() []Condition { return c.children }
+
+// Field returns the compared field name of a leaf node.
+func (c Condition) Field() string { return c.field }
+
+// Op returns the comparison operator of a leaf node.
+func (c Condition) Op() Operator { return c.op }
+
+// Values returns the leaf node's comparison values, in order.
+func (c Condition) Values() []interface{} { return c.values }
@@ -0,0 +1,68 @@
+package entity
+
+// Field identifies a column belonging to entity type T by its SQL column
+// name, which QueryBuilder quotes verbatim into generated statements.
+// QueryBuilder and GenericRepository use it to build type-checked WHERE
+// clauses instead of raw strings. QueryBuilder.Include is the exception:
+// there a Field names a relation by its Go struct field name.
+type Field[T any] string
+
+// Eq builds a "field = value" condition.
+func (f Field[T]) Eq(value interface{}) Condition { return leaf(string(f), OpEq, value) }
+
+// Gt builds a "field > value" condition.
+func (f Field[T]) Gt(value interface{}) Condition { return leaf(string(f), OpGt, value) }
+
+// Gte builds a "field >= value" condition.
+func (f Field[T]) Gte(value interface{}) Condition { return leaf(string(f), OpGte, value) }
+
+// Lt builds a "field < value" condition.
+func (f Field[T]) Lt(value interface{}) Condition { return leaf(string(f), OpLt, value) }
+
+// Lte builds a "field <= value" condition.
+func (f Field[T]) Lte(value interface{}) Condition { return leaf(string(f), OpLte, value) }
+
+// Ne builds a "field <> value" condition.
+func (f Field[T]) Ne(value interface{}) Condition { return leaf(string(f), OpNe, value) }
+
+// In builds a "field IN (values...)" condition.
+func (f Field[T]) In(values ...interface{}) Condition { return leaf(string(f), OpIn, values...) }
+
+// NotIn builds a "field NOT IN (values...)" condition.
+func (f Field[T]) NotIn(values ...interface{}) Condition {
+	return leaf(string(f), OpNotIn, values...)
+}
+
+// Between builds a "field BETWEEN low AND high" condition.
+func (f Field[T]) Between(low, high interface{}) Condition {
+	return leaf(string(f), OpBetween, low, high)
+}
+
+// Like builds a "field LIKE pattern" condition.
+func (f Field[T]) Like(pattern string) Condition { return leaf(string(f), OpLike, pattern) }
+
+// ILike builds a case-insensitive LIKE condition. The Dialect renders it
+// as native ILIKE on Postgres and as LOWER(field) LIKE LOWER(pattern)
+// elsewhere, so it works across every supported dialect.
+func (f Field[T]) ILike(pattern string) Condition { return leaf(string(f), OpILike, pattern) }
+
+// StartsWith builds a "field LIKE prefix%" condition.
+func (f Field[T]) StartsWith(prefix string) Condition {
+	return leaf(string(f), OpLike, prefix+"%")
+}
+
+// EndsWith builds a "field LIKE %suffix" condition.
+func (f Field[T]) EndsWith(suffix string) Condition {
+	return leaf(string(f), OpLike, "%"+suffix)
+}
+
+// Contains builds a "field LIKE %substr%" condition.
+func (f Field[T]) Contains(substr string) Condition {
+	return leaf(string(f), OpLike, "%"+substr+"%")
+}
+
+// IsNull builds a "field IS NULL" condition.
+func (f Field[T]) IsNull() Condition { return leaf(string(f), OpIsNull) }
+
+// IsNotNull builds a "field IS NOT NULL" condition.
+func (f Field[T]) IsNotNull() Condition { return leaf(string(f), OpIsNotNull) }
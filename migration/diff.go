@@ -0,0 +1,124 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/binpqh/GoBase/query"
+	"github.com/binpqh/GoBase/utils"
+)
+
+// Diff compares an entity's utils.Schema against the live columns of its
+// table in db, read from information_schema.columns, and returns the DDL
+// needed to catch the table up: a single CREATE TABLE when the table
+// doesn't exist yet, or one ALTER TABLE ADD COLUMN per column present in
+// schema but missing live. Placeholders and identifiers are rendered for
+// dialect, so the statements are portable across the dialects QueryBuilder
+// supports.
+//
+// Params:
+//   - ctx: Context governing cancellation.
+//   - db: The live database connection to inspect.
+//   - dialect: The SQL dialect to render the statements for.
+//   - schema: The entity schema to diff against, e.g. from utils.SchemaOf.
+//
+// Returns:
+//   - []string: The DDL statements to apply, in order.
+//   - error: Error if information_schema can't be queried.
+func Diff(ctx context.Context, db *sql.DB, dialect query.Dialect, schema utils.Schema) ([]string, error) {
+	liveColumns, err := tableColumns(ctx, db, dialect, schema.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(liveColumns) == 0 {
+		return []string{createTableStatement(dialect, schema)}, nil
+	}
+
+	var statements []string
+	for _, col := range schema.Columns {
+		if liveColumns[col.ColumnName] {
+			continue
+		}
+		statements = append(statements, fmt.Sprintf(
+			"ALTER TABLE %s ADD COLUMN %s %s",
+			dialect.QuoteIdentifier(schema.TableName), dialect.QuoteIdentifier(col.ColumnName), sqlType(col),
+		))
+	}
+	return statements, nil
+}
+
+// DiffAll runs Diff for each model's schema, as resolved by
+// utils.SchemaForType. models are typically the same instances already
+// passed to utils.RegisterModels.
+//
+// Params:
+//   - ctx: Context governing cancellation.
+//   - db: The live database connection to inspect.
+//   - dialect: The SQL dialect to render the statements for.
+//   - models: Entity instances (or pointers) to diff.
+//
+// Returns:
+//   - []string: The DDL statements to apply, across all models, in order.
+//   - error: Error if any model's Diff fails.
+func DiffAll(ctx context.Context, db *sql.DB, dialect query.Dialect, models ...interface{}) ([]string, error) {
+	var statements []string
+	for _, model := range models {
+		t := reflect.TypeOf(model)
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		modelStatements, err := Diff(ctx, db, dialect, utils.SchemaForType(t))
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, modelStatements...)
+	}
+	return statements, nil
+}
+
+func tableColumns(ctx context.Context, db *sql.DB, dialect query.Dialect, tableName string) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT column_name FROM information_schema.columns WHERE table_name = "+dialect.Placeholder(1), tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columns[name] = true
+	}
+	return columns, rows.Err()
+}
+
+func createTableStatement(dialect query.Dialect, schema utils.Schema) string {
+	defs := make([]string, len(schema.Columns))
+	for i, col := range schema.Columns {
+		def := dialect.QuoteIdentifier(col.ColumnName) + " " + sqlType(col)
+		if col.PrimaryKey {
+			def += " PRIMARY KEY"
+		}
+		defs[i] = def
+	}
+	return "CREATE TABLE " + dialect.QuoteIdentifier(schema.TableName) + " (" + strings.Join(defs, ", ") + ")"
+}
+
+// sqlType is a conservative guess at a column's SQL type, used only to
+// generate new DDL from a Column, which carries no Go type information.
+// Migrations that need precise types should write their own Up/Down SQL
+// instead of relying on Diff.
+func sqlType(col utils.Column) string {
+	if col.AutoIncrement {
+		return "INTEGER"
+	}
+	return "VARCHAR(255)"
+}
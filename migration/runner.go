@@ -0,0 +1,167 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/binpqh/GoBase/query"
+)
+
+// Runner applies a set of migration Sources against a *sql.DB, tracking
+// which have already run in a schema_migrations table.
+type Runner struct {
+	db      *sql.DB
+	dialect query.Dialect
+	sources []Source
+}
+
+// NewRunner creates a Runner targeting MySQL. Use NewRunnerFor to target a
+// different database engine.
+func NewRunner(db *sql.DB, sources ...Source) *Runner {
+	return NewRunnerFor(db, query.MySQLDialect{}, sources...)
+}
+
+// NewRunnerFor creates a Runner that renders its bookkeeping SQL for the
+// given dialect, applying sources in ascending ID order regardless of the
+// order they're passed in.
+func NewRunnerFor(db *sql.DB, dialect query.Dialect, sources ...Source) *Runner {
+	sorted := append([]Source{}, sources...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID() < sorted[j].ID() })
+	return &Runner{db: db, dialect: dialect, sources: sorted}
+}
+
+// Up applies every pending migration, in ascending ID order, each inside
+// its own transaction alongside the schema_migrations bookkeeping row.
+//
+// Params:
+//   - ctx: Context governing cancellation.
+//
+// Returns:
+//   - error: Error if ensuring the tracking table, reading a migration's
+//     Up script, or applying it fails.
+func (r *Runner) Up(ctx context.Context) error {
+	if err := r.ensureTable(ctx); err != nil {
+		return err
+	}
+
+	applied, err := r.appliedVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, src := range r.sources {
+		if applied[src.ID()] {
+			continue
+		}
+		if err := r.apply(ctx, src, src.Up(), true); err != nil {
+			return fmt.Errorf("migration: applying %d: %w", src.ID(), err)
+		}
+	}
+	return nil
+}
+
+// Rollback reverts the steps most recently applied migrations, in
+// descending ID order, each inside its own transaction.
+//
+// Params:
+//   - ctx: Context governing cancellation.
+//   - steps: The number of migrations to revert.
+//
+// Returns:
+//   - error: Error if ensuring the tracking table, reading a migration's
+//     Down script, or reverting it fails.
+func (r *Runner) Rollback(ctx context.Context, steps int) error {
+	if err := r.ensureTable(ctx); err != nil {
+		return err
+	}
+
+	applied, err := r.appliedVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var toRevert []Source
+	for i := len(r.sources) - 1; i >= 0 && len(toRevert) < steps; i-- {
+		if src := r.sources[i]; applied[src.ID()] {
+			toRevert = append(toRevert, src)
+		}
+	}
+
+	for _, src := range toRevert {
+		if err := r.apply(ctx, src, src.Down(), false); err != nil {
+			return fmt.Errorf("migration: reverting %d: %w", src.ID(), err)
+		}
+	}
+	return nil
+}
+
+// apply runs script in a transaction and, in the same transaction,
+// records (up) or removes (!up) src's bookkeeping row.
+func (r *Runner) apply(ctx context.Context, src Source, script io.ReadCloser, up bool) (err error) {
+	defer script.Close()
+
+	statement, err := io.ReadAll(script)
+	if err != nil {
+		return err
+	}
+
+	sqlTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			sqlTx.Rollback()
+		}
+	}()
+
+	if _, err = sqlTx.ExecContext(ctx, string(statement)); err != nil {
+		return err
+	}
+
+	table := r.dialect.QuoteIdentifier("schema_migrations")
+	version := r.dialect.QuoteIdentifier("version")
+	placeholder := r.dialect.Placeholder(1)
+
+	if up {
+		_, err = sqlTx.ExecContext(ctx, "INSERT INTO "+table+" ("+version+") VALUES ("+placeholder+")", src.ID())
+	} else {
+		_, err = sqlTx.ExecContext(ctx, "DELETE FROM "+table+" WHERE "+version+" = "+placeholder, src.ID())
+	}
+	if err != nil {
+		return err
+	}
+
+	return sqlTx.Commit()
+}
+
+func (r *Runner) ensureTable(ctx context.Context) error {
+	table := r.dialect.QuoteIdentifier("schema_migrations")
+	version := r.dialect.QuoteIdentifier("version")
+	_, err := r.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+table+" ("+version+" BIGINT PRIMARY KEY)")
+	return err
+}
+
+func (r *Runner) appliedVersions(ctx context.Context) (map[uint]bool, error) {
+	table := r.dialect.QuoteIdentifier("schema_migrations")
+	version := r.dialect.QuoteIdentifier("version")
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+version+" FROM "+table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[uint]bool)
+	for rows.Next() {
+		var v uint
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		applied[v] = true
+	}
+	return applied, rows.Err()
+}
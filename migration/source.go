@@ -0,0 +1,14 @@
+// Package migration applies versioned SQL migrations against a *sql.DB,
+// tracking which have already run, and can diff an entity's utils.Schema
+// against a live table to suggest the DDL needed to catch it up.
+package migration
+
+import "io"
+
+// Source is a single migration: a monotonically increasing ID and the SQL
+// to apply it (Up) or revert it (Down).
+type Source interface {
+	ID() uint
+	Up() io.ReadCloser
+	Down() io.ReadCloser
+}
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/binpqh/GoBase/entity"
+)
+
+// paramWriter accumulates query arguments in encounter order and renders
+// each one through a Dialect's placeholder syntax ("?", "$1", "@p1", ...).
+type paramWriter struct {
+	dialect Dialect
+	args    []interface{}
+}
+
+// add records value as the next argument and returns its placeholder.
+func (w *paramWriter) add(value interface{}) string {
+	w.args = append(w.args, value)
+	return w.dialect.Placeholder(len(w.args))
+}
+
+// renderWhere joins the top-level WHERE conditions of a QueryBuilder with
+// AND and renders them to a single SQL fragment, recording every
+// encountered value on w. It returns an empty string when conditions is
+// empty.
+func renderWhere(conditions []entity.Condition, w *paramWriter) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	parts := make([]string, len(conditions))
+	for i, cond := range conditions {
+		parts[i] = renderCondition(cond, w)
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// renderCondition serialises a Condition tree node into a SQL fragment,
+// rendering placeholders and quoting identifiers through w's Dialect.
+func renderCondition(cond entity.Condition, w *paramWriter) string {
+	if cond.IsGroup() {
+		return renderGroup(cond, w)
+	}
+	return renderLeaf(cond, w)
+}
+
+func renderGroup(cond entity.Condition, w *paramWriter) string {
+	children := cond.Children()
+	if cond.Group() == "NOT" {
+		return "NOT (" + renderCondition(children[0], w) + ")"
+	}
+
+	parts := make([]string, len(children))
+	for i, child := range children {
+		parts[i] = renderCondition(child, w)
+	}
+	return "(" + strings.Join(parts, " "+cond.Group()+" ") + ")"
+}
+
+func renderLeaf(cond entity.Condition, w *paramWriter) string {
+	field := w.dialect.QuoteIdentifier(cond.Field())
+	values := cond.Values()
+
+	switch cond.Op() {
+	case entity.OpIsNull, entity.OpIsNotNull:
+		return field + " " + string(cond.Op())
+	case entity.OpIn, entity.OpNotIn:
+		placeholders := make([]string, len(values))
+		for i, v := range values {
+			placeholders[i] = w.add(v)
+		}
+		return field + " " + string(cond.Op()) + " (" + strings.Join(placeholders, ", ") + ")"
+	case entity.OpBetween:
+		return field + " BETWEEN " + w.add(values[0]) + " AND " + w.add(values[1])
+	case entity.OpILike:
+		return w.dialect.CaseInsensitiveLike(field, w.add(values[0]))
+	default:
+		return field + " " + string(cond.Op()) + " " + w.add(values[0])
+	}
+}
@@ -0,0 +1,108 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/binpqh/GoBase/entity"
+)
+
+func TestRenderWhereEmpty(t *testing.T) {
+	pw := &paramWriter{dialect: PostgresDialect{}}
+	if got := renderWhere(nil, pw); got != "" {
+		t.Errorf("renderWhere(nil) = %q, want empty string", got)
+	}
+}
+
+func TestRenderLeafOperators(t *testing.T) {
+	tests := []struct {
+		name string
+		cond entity.Condition
+		want string
+		args []interface{}
+	}{
+		{"eq", entity.Field[struct{}]("age").Eq(30), `"age" = $1`, []interface{}{30}},
+		{"gt", entity.Field[struct{}]("age").Gt(30), `"age" > $1`, []interface{}{30}},
+		{"ne", entity.Field[struct{}]("age").Ne(30), `"age" <> $1`, []interface{}{30}},
+		{"like", entity.Field[struct{}]("name").Like("a%"), `"name" LIKE $1`, []interface{}{"a%"}},
+		{"is null", entity.Field[struct{}]("deleted_at").IsNull(), `"deleted_at" IS NULL`, nil},
+		{"is not null", entity.Field[struct{}]("deleted_at").IsNotNull(), `"deleted_at" IS NOT NULL`, nil},
+		{"in", entity.Field[struct{}]("id").In(1, 2, 3), `"id" IN ($1, $2, $3)`, []interface{}{1, 2, 3}},
+		{"not in", entity.Field[struct{}]("id").NotIn(1, 2), `"id" NOT IN ($1, $2)`, []interface{}{1, 2}},
+		{"between", entity.Field[struct{}]("age").Between(18, 65), `"age" BETWEEN $1 AND $2`, []interface{}{18, 65}},
+		{"ilike", entity.Field[struct{}]("name").ILike("a%"), `"name" ILIKE $1`, []interface{}{"a%"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pw := &paramWriter{dialect: PostgresDialect{}}
+			got := renderLeaf(tt.cond, pw)
+			if got != tt.want {
+				t.Errorf("renderLeaf(%s) = %q, want %q", tt.name, got, tt.want)
+			}
+			if len(pw.args) != len(tt.args) {
+				t.Errorf("renderLeaf(%s) recorded args %v, want %v", tt.name, pw.args, tt.args)
+			}
+		})
+	}
+}
+
+func TestRenderConditionGroups(t *testing.T) {
+	cond := entity.And(
+		entity.Field[struct{}]("age").Gte(18),
+		entity.Or(
+			entity.Field[struct{}]("name").Eq("alice"),
+			entity.Not(entity.Field[struct{}]("active").Eq(false)),
+		),
+	)
+
+	pw := &paramWriter{dialect: MySQLDialect{}}
+	got := renderCondition(cond, pw)
+	want := "(`age` >= ? AND (`name` = ? OR NOT (`active` = ?)))"
+	if got != want {
+		t.Errorf("renderCondition(And(...)) = %q, want %q", got, want)
+	}
+	wantArgs := []interface{}{18, "alice", false}
+	if len(pw.args) != len(wantArgs) {
+		t.Errorf("recorded args %v, want %v", pw.args, wantArgs)
+	}
+}
+
+// TestRenderWherePlaceholderNumbering checks that a single renderWhere call
+// gives every value across multiple top-level conditions its own,
+// correctly incrementing placeholder.
+func TestRenderWherePlaceholderNumbering(t *testing.T) {
+	conds := []entity.Condition{
+		entity.Field[struct{}]("age").Gt(18),
+		entity.Field[struct{}]("name").Eq("bob"),
+	}
+	pw := &paramWriter{dialect: PostgresDialect{}}
+	got := renderWhere(conds, pw)
+	want := `"age" > $1 AND "name" = $2`
+	if got != want {
+		t.Errorf("renderWhere(...) = %q, want %q", got, want)
+	}
+}
+
+// TestCaseInsensitiveLikeAcrossDialects confirms ILike's SQL fragment is
+// portable: native ILIKE on Postgres, LOWER(...) LIKE LOWER(...) on every
+// other supported dialect.
+func TestCaseInsensitiveLikeAcrossDialects(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		want    string
+	}{
+		{"mysql", MySQLDialect{}, "LOWER(`name`) LIKE LOWER(?)"},
+		{"postgres", PostgresDialect{}, `"name" ILIKE $1`},
+		{"sqlite", SQLiteDialect{}, `LOWER("name") LIKE LOWER(?)`},
+		{"sqlserver", SQLServerDialect{}, "LOWER([name]) LIKE LOWER(@p1)"},
+	}
+	cond := entity.Field[struct{}]("name").ILike("a%")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pw := &paramWriter{dialect: tt.dialect}
+			if got := renderLeaf(cond, pw); got != tt.want {
+				t.Errorf("renderLeaf(ILike) = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package query
+
+import "strconv"
+
+// Dialect abstracts the SQL syntax differences between database engines:
+// parameter placeholders, identifier quoting, and whether INSERT/UPDATE
+// can request generated values back via a RETURNING clause.
+type Dialect interface {
+	// Placeholder returns the parameter marker for the n-th argument of a
+	// statement (1-indexed).
+	Placeholder(n int) string
+	// QuoteIdentifier quotes a table or column name for safe inclusion in
+	// generated SQL.
+	QuoteIdentifier(name string) string
+	// SupportsReturning reports whether INSERT/UPDATE statements can
+	// append a RETURNING clause to read back generated values.
+	SupportsReturning() bool
+	// LimitOffset renders the fragment appended after ORDER BY to fetch
+	// one page of limit rows starting at offset, recording both as
+	// parameters through w.
+	LimitOffset(w *paramWriter, limit, offset int) string
+	// CurrentTimestamp returns the SQL expression for the database's
+	// current time, for use in generated UPDATE/soft-delete statements.
+	CurrentTimestamp() string
+	// SelectLimit renders the fragment, if any, that must appear
+	// immediately after SELECT to cap a query's row count without an
+	// OFFSET (SQL Server's TOP, which needs no ORDER BY). Dialects that
+	// express the cap via TrailingLimit instead return "".
+	SelectLimit(limit int) string
+	// TrailingLimit renders the fragment appended after ORDER BY to cap a
+	// query's row count without an OFFSET (a plain LIMIT), recording
+	// limit as a parameter through w. Dialects that express the cap via
+	// SelectLimit instead return "".
+	TrailingLimit(w *paramWriter, limit int) string
+	// CaseInsensitiveLike renders a case-insensitive LIKE comparison
+	// between field and placeholder. Dialects without native ILIKE wrap
+	// both sides in LOWER(...) instead.
+	CaseInsensitiveLike(field, placeholder string) string
+}
+
+// MySQLDialect targets MySQL/MariaDB: "?" placeholders, backtick-quoted
+// identifiers, and no RETURNING support.
+type MySQLDialect struct{}
+
+func (MySQLDialect) Placeholder(int) string             { return "?" }
+func (MySQLDialect) QuoteIdentifier(name string) string { return "`" + name + "`" }
+func (MySQLDialect) SupportsReturning() bool            { return false }
+func (MySQLDialect) LimitOffset(w *paramWriter, limit, offset int) string {
+	return "LIMIT " + w.add(limit) + " OFFSET " + w.add(offset)
+}
+func (MySQLDialect) CurrentTimestamp() string { return "NOW()" }
+func (MySQLDialect) SelectLimit(int) string   { return "" }
+func (MySQLDialect) TrailingLimit(w *paramWriter, limit int) string {
+	return "LIMIT " + w.add(limit)
+}
+func (MySQLDialect) CaseInsensitiveLike(field, placeholder string) string {
+	return "LOWER(" + field + ") LIKE LOWER(" + placeholder + ")"
+}
+
+// PostgresDialect targets PostgreSQL: "$1".."$n" placeholders,
+// double-quoted identifiers, and RETURNING support.
+type PostgresDialect struct{}
+
+func (PostgresDialect) Placeholder(n int) string           { return "$" + strconv.Itoa(n) }
+func (PostgresDialect) QuoteIdentifier(name string) string { return `"` + name + `"` }
+func (PostgresDialect) SupportsReturning() bool            { return true }
+func (PostgresDialect) LimitOffset(w *paramWriter, limit, offset int) string {
+	return "LIMIT " + w.add(limit) + " OFFSET " + w.add(offset)
+}
+func (PostgresDialect) CurrentTimestamp() string { return "NOW()" }
+func (PostgresDialect) SelectLimit(int) string   { return "" }
+func (PostgresDialect) TrailingLimit(w *paramWriter, limit int) string {
+	return "LIMIT " + w.add(limit)
+}
+func (PostgresDialect) CaseInsensitiveLike(field, placeholder string) string {
+	return field + " ILIKE " + placeholder
+}
+
+// SQLiteDialect targets SQLite: "?" placeholders, double-quoted
+// identifiers, and no RETURNING support.
+type SQLiteDialect struct{}
+
+func (SQLiteDialect) Placeholder(int) string             { return "?" }
+func (SQLiteDialect) QuoteIdentifier(name string) string { return `"` + name + `"` }
+func (SQLiteDialect) SupportsReturning() bool            { return false }
+func (SQLiteDialect) LimitOffset(w *paramWriter, limit, offset int) string {
+	return "LIMIT " + w.add(limit) + " OFFSET " + w.add(offset)
+}
+func (SQLiteDialect) CurrentTimestamp() string { return "CURRENT_TIMESTAMP" }
+func (SQLiteDialect) SelectLimit(int) string   { return "" }
+func (SQLiteDialect) TrailingLimit(w *paramWriter, limit int) string {
+	return "LIMIT " + w.add(limit)
+}
+func (SQLiteDialect) CaseInsensitiveLike(field, placeholder string) string {
+	return "LOWER(" + field + ") LIKE LOWER(" + placeholder + ")"
+}
+
+// SQLServerDialect targets SQL Server: "@p1".."@pn" placeholders,
+// bracket-quoted identifiers, and no RETURNING support.
+type SQLServerDialect struct{}
+
+func (SQLServerDialect) Placeholder(n int) string           { return "@p" + strconv.Itoa(n) }
+func (SQLServerDialect) QuoteIdentifier(name string) string { return "[" + name + "]" }
+func (SQLServerDialect) SupportsReturning() bool            { return false }
+
+// LimitOffset uses OFFSET/FETCH NEXT, which SQL Server requires an ORDER
+// BY clause to accompany.
+func (SQLServerDialect) LimitOffset(w *paramWriter, limit, offset int) string {
+	return "OFFSET " + w.add(offset) + " ROWS FETCH NEXT " + w.add(limit) + " ROWS ONLY"
+}
+func (SQLServerDialect) CurrentTimestamp() string { return "GETDATE()" }
+
+// SelectLimit uses TOP, which needs no ORDER BY, unlike LimitOffset's
+// OFFSET/FETCH NEXT.
+func (SQLServerDialect) SelectLimit(limit int) string {
+	return "TOP (" + strconv.Itoa(limit) + ") "
+}
+
+// TrailingLimit is unused: SQL Server expresses a plain row cap via
+// SelectLimit's TOP instead.
+func (SQLServerDialect) TrailingLimit(*paramWriter, int) string { return "" }
+
+func (SQLServerDialect) CaseInsensitiveLike(field, placeholder string) string {
+	return "LOWER(" + field + ") LIKE LOWER(" + placeholder + ")"
+}
@@ -0,0 +1,119 @@
+package query
+
+import "testing"
+
+func TestDialectPlaceholder(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		n       int
+		want    string
+	}{
+		{"mysql", MySQLDialect{}, 1, "?"},
+		{"postgres first", PostgresDialect{}, 1, "$1"},
+		{"postgres third", PostgresDialect{}, 3, "$3"},
+		{"sqlite", SQLiteDialect{}, 1, "?"},
+		{"sqlserver first", SQLServerDialect{}, 1, "@p1"},
+		{"sqlserver third", SQLServerDialect{}, 3, "@p3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.Placeholder(tt.n); got != tt.want {
+				t.Errorf("Placeholder(%d) = %q, want %q", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialectQuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		want    string
+	}{
+		{"mysql", MySQLDialect{}, "`users`"},
+		{"postgres", PostgresDialect{}, `"users"`},
+		{"sqlite", SQLiteDialect{}, `"users"`},
+		{"sqlserver", SQLServerDialect{}, "[users]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.QuoteIdentifier("users"); got != tt.want {
+				t.Errorf("QuoteIdentifier(%q) = %q, want %q", "users", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDialectSelectAndTrailingLimit exercises the single-row cap (no
+// offset) used by a plain Limit(n) call, e.g. GetByID's Limit(1). Exactly
+// one of SelectLimit/TrailingLimit should render a non-empty fragment per
+// dialect, and the chosen one must account for every argument it adds.
+func TestDialectSelectAndTrailingLimit(t *testing.T) {
+	tests := []struct {
+		name           string
+		dialect        Dialect
+		wantSelectFrag string
+		wantTrailing   string
+		wantArgs       []interface{}
+	}{
+		{"mysql", MySQLDialect{}, "", "LIMIT ?", []interface{}{5}},
+		{"postgres", PostgresDialect{}, "", "LIMIT $1", []interface{}{5}},
+		{"sqlite", SQLiteDialect{}, "", "LIMIT ?", []interface{}{5}},
+		{"sqlserver", SQLServerDialect{}, "TOP (5) ", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.SelectLimit(5); got != tt.wantSelectFrag {
+				t.Errorf("SelectLimit(5) = %q, want %q", got, tt.wantSelectFrag)
+			}
+
+			pw := &paramWriter{dialect: tt.dialect}
+			if got := tt.dialect.TrailingLimit(pw, 5); got != tt.wantTrailing {
+				t.Errorf("TrailingLimit(5) = %q, want %q", got, tt.wantTrailing)
+			}
+			if len(pw.args) != len(tt.wantArgs) {
+				t.Errorf("TrailingLimit recorded args %v, want %v", pw.args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+// TestDialectCaseInsensitiveLike verifies that ILike renders as native
+// ILIKE only on Postgres, and as a portable LOWER(...) LIKE LOWER(...)
+// comparison everywhere else.
+func TestDialectCaseInsensitiveLike(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		want    string
+	}{
+		{"mysql", MySQLDialect{}, "LOWER(`name`) LIKE LOWER(?)"},
+		{"postgres", PostgresDialect{}, `"name" ILIKE $1`},
+		{"sqlite", SQLiteDialect{}, `LOWER("name") LIKE LOWER(?)`},
+		{"sqlserver", SQLServerDialect{}, "LOWER([name]) LIKE LOWER(@p1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pw := &paramWriter{dialect: tt.dialect}
+			field := tt.dialect.QuoteIdentifier("name")
+			placeholder := pw.add("%pat%")
+			if got := tt.dialect.CaseInsensitiveLike(field, placeholder); got != tt.want {
+				t.Errorf("CaseInsensitiveLike(...) = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLServerTrailingLimitIsEmpty(t *testing.T) {
+	// SQLServerDialect expresses a plain cap via TOP (SelectLimit), not a
+	// trailing LIMIT/OFFSET fragment, since TOP needs no ORDER BY.
+	var d SQLServerDialect
+	pw := &paramWriter{dialect: d}
+	if got := d.TrailingLimit(pw, 10); got != "" {
+		t.Errorf("TrailingLimit(10) = %q, want empty string", got)
+	}
+	if len(pw.args) != 0 {
+		t.Errorf("TrailingLimit recorded args %v, want none", pw.args)
+	}
+}
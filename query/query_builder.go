@@ -3,32 +3,64 @@ package query
 import (
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/binpqh/GoBase/entity"
+	"github.com/binpqh/GoBase/utils"
 )
 
+// orderSpec is a single ORDER BY entry: a field name and its direction.
+type orderSpec struct {
+	field     string
+	direction string
+}
+
 // QueryBuilder constructs SQL queries dynamically for entities implementing EntityBase.
 type QueryBuilder[T any] struct {
-	selectFields []string
-	whereClauses []string
-	whereArgs    []interface{}
-	joins        []string
-	limit        int
-	orderBy      []string
-	insertFields []string
-	insertValues []interface{}
-	updateFields []string
+	dialect         Dialect
+	selectFields    []string
+	whereConditions []entity.Condition
+	joins           []string
+	limit           int
+	orderBy         []orderSpec
+	insertFields    []string
+	insertValues    []interface{}
+	updateFields    []string
+	updateValues    []interface{}
+	isDelete        bool
+	softDeleteCol   string
+	includes        []string
+	paginate        bool
+	page            int
+	pageSize        int
 }
 
-// NewQueryBuilder creates a new QueryBuilder instance for the specified entity type.
+// NewQueryBuilder creates a new QueryBuilder instance for the specified
+// entity type, using MySQLDialect. Use NewQueryBuilderFor to target a
+// different database engine.
 //
 // Returns:
 //   - *QueryBuilder[T]: A new instance of QueryBuilder.
 func NewQueryBuilder[T any]() *QueryBuilder[T] {
-	return &QueryBuilder[T]{}
+	return NewQueryBuilderFor[T](MySQLDialect{})
+}
+
+// NewQueryBuilderFor creates a new QueryBuilder instance for the specified
+// entity type, rendering placeholders and identifiers through dialect.
+//
+// Params:
+//   - dialect: The SQL dialect to render the query for.
+//
+// Returns:
+//   - *QueryBuilder[T]: A new instance of QueryBuilder.
+func NewQueryBuilderFor[T any](dialect Dialect) *QueryBuilder[T] {
+	return &QueryBuilder[T]{dialect: dialect}
 }
 
-// Insert generates an INSERT INTO statement.
+// Insert generates an INSERT INTO statement. Primary key columns holding
+// their zero value are omitted so the database can assign them (e.g. an
+// autoincrement ID). CreatedAt/UpdatedAt columns, if the entity has them,
+// are stamped with the current time rather than the struct's own value.
 //
 // Params:
 //   - data: The entity data to insert (must be a struct).
@@ -36,19 +68,31 @@ func NewQueryBuilder[T any]() *QueryBuilder[T] {
 // Returns:
 //   - *QueryBuilder[T]: The updated QueryBuilder instance.
 func (qb *QueryBuilder[T]) Insert(data T) *QueryBuilder[T] {
+	schema := utils.SchemaOf[T]()
 	v := reflect.ValueOf(data)
-	t := reflect.TypeOf(data)
+	now := time.Now()
+
+	for _, col := range schema.Columns {
+		fieldValue := v.FieldByIndex(col.Index)
+		if col.PrimaryKey && fieldValue.IsZero() {
+			continue
+		}
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		value := v.Field(i).Interface()
-		qb.insertFields = append(qb.insertFields, field.Name)
+		value := fieldValue.Interface()
+		if col.ColumnName == schema.CreatedAtCol || col.ColumnName == schema.UpdatedAtCol {
+			value = now
+		}
+
+		qb.insertFields = append(qb.insertFields, col.ColumnName)
 		qb.insertValues = append(qb.insertValues, value)
 	}
 	return qb
 }
 
-// Update generates an UPDATE statement.
+// Update generates an UPDATE statement. Primary key columns are excluded
+// from the SET clause, since they identify the row rather than change it.
+// The UpdatedAt column, if the entity has one, is stamped with the
+// current time rather than the struct's own value.
 //
 // Params:
 //   - data: The entity data to update.
@@ -56,23 +100,38 @@ func (qb *QueryBuilder[T]) Insert(data T) *QueryBuilder[T] {
 // Returns:
 //   - *QueryBuilder[T]: The updated QueryBuilder instance.
 func (qb *QueryBuilder[T]) Update(data T) *QueryBuilder[T] {
+	schema := utils.SchemaOf[T]()
 	v := reflect.ValueOf(data)
-	t := reflect.TypeOf(data)
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		value := v.Field(i).Interface()
-		qb.updateFields = append(qb.updateFields, field.Name+" = ?")
-		qb.whereArgs = append(qb.whereArgs, value)
+	for _, col := range schema.Columns {
+		if col.PrimaryKey {
+			continue
+		}
+
+		value := v.FieldByIndex(col.Index).Interface()
+		if col.ColumnName == schema.UpdatedAtCol {
+			value = time.Now()
+		}
+
+		qb.updateFields = append(qb.updateFields, col.ColumnName)
+		qb.updateValues = append(qb.updateValues, value)
 	}
 	return qb
 }
 
-// Delete generates a DELETE statement.
+// Delete generates a DELETE statement, unless the entity has a soft-delete
+// column, in which case it generates an UPDATE that stamps that column
+// with the current time instead of removing the row.
 //
 // Returns:
 //   - *QueryBuilder[T]: The updated QueryBuilder instance.
 func (qb *QueryBuilder[T]) Delete() *QueryBuilder[T] {
+	schema := utils.SchemaOf[T]()
+	if schema.IsSoftDelete() {
+		qb.softDeleteCol = schema.DeleteAtCol
+		return qb
+	}
+	qb.isDelete = true
 	return qb
 }
 
@@ -90,6 +149,21 @@ func (qb *QueryBuilder[T]) Select(fields ...entity.Field[T]) *QueryBuilder[T] {
 	return qb
 }
 
+// Where adds a raw Condition to the query, ANDed with any other WHERE
+// conditions already added. It is the building block behind the
+// WhereEqual/WhereGT/... helpers and WhereAny/WhereAll, and also accepts
+// Conditions composed directly from entity.Field methods.
+//
+// Params:
+//   - cond: The condition to add.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) Where(cond entity.Condition) *QueryBuilder[T] {
+	qb.whereConditions = append(qb.whereConditions, cond)
+	return qb
+}
+
 // WhereEqual adds a WHERE condition filtering results where a field equals a given value.
 //
 // Params:
@@ -99,9 +173,212 @@ func (qb *QueryBuilder[T]) Select(fields ...entity.Field[T]) *QueryBuilder[T] {
 // Returns:
 //   - *QueryBuilder[T]: The updated QueryBuilder instance.
 func (qb *QueryBuilder[T]) WhereEqual(field entity.Field[T], value interface{}) *QueryBuilder[T] {
-	qb.whereClauses = append(qb.whereClauses, string(field)+" = ?")
-	qb.whereArgs = append(qb.whereArgs, value)
-	return qb
+	return qb.Where(field.Eq(value))
+}
+
+// WhereGT adds a WHERE condition filtering results where a field is greater than a given value.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - value: The value to compare against.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereGT(field entity.Field[T], value interface{}) *QueryBuilder[T] {
+	return qb.Where(field.Gt(value))
+}
+
+// WhereGTE adds a WHERE condition filtering results where a field is greater than or equal to a given value.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - value: The value to compare against.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereGTE(field entity.Field[T], value interface{}) *QueryBuilder[T] {
+	return qb.Where(field.Gte(value))
+}
+
+// WhereLT adds a WHERE condition filtering results where a field is less than a given value.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - value: The value to compare against.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereLT(field entity.Field[T], value interface{}) *QueryBuilder[T] {
+	return qb.Where(field.Lt(value))
+}
+
+// WhereLTE adds a WHERE condition filtering results where a field is less than or equal to a given value.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - value: The value to compare against.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereLTE(field entity.Field[T], value interface{}) *QueryBuilder[T] {
+	return qb.Where(field.Lte(value))
+}
+
+// WhereNE adds a WHERE condition filtering results where a field is not equal to a given value.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - value: The value to compare against.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereNE(field entity.Field[T], value interface{}) *QueryBuilder[T] {
+	return qb.Where(field.Ne(value))
+}
+
+// WhereIn adds a WHERE condition filtering results where a field matches one of the given values.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - values: The candidate values.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereIn(field entity.Field[T], values ...interface{}) *QueryBuilder[T] {
+	return qb.Where(field.In(values...))
+}
+
+// WhereNotIn adds a WHERE condition filtering results where a field matches none of the given values.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - values: The excluded values.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereNotIn(field entity.Field[T], values ...interface{}) *QueryBuilder[T] {
+	return qb.Where(field.NotIn(values...))
+}
+
+// WhereBetween adds a WHERE condition filtering results where a field falls within [low, high].
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - low: The lower bound, inclusive.
+//   - high: The upper bound, inclusive.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereBetween(field entity.Field[T], low, high interface{}) *QueryBuilder[T] {
+	return qb.Where(field.Between(low, high))
+}
+
+// WhereLike adds a WHERE condition filtering results where a field matches a LIKE pattern.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - pattern: The LIKE pattern, e.g. "%term%".
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereLike(field entity.Field[T], pattern string) *QueryBuilder[T] {
+	return qb.Where(field.Like(pattern))
+}
+
+// WhereILike adds a WHERE condition filtering results where a field matches a case-insensitive LIKE pattern.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - pattern: The ILIKE pattern, e.g. "%term%".
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereILike(field entity.Field[T], pattern string) *QueryBuilder[T] {
+	return qb.Where(field.ILike(pattern))
+}
+
+// WhereStartsWith adds a WHERE condition filtering results where a field starts with the given prefix.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - prefix: The required prefix.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereStartsWith(field entity.Field[T], prefix string) *QueryBuilder[T] {
+	return qb.Where(field.StartsWith(prefix))
+}
+
+// WhereEndsWith adds a WHERE condition filtering results where a field ends with the given suffix.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - suffix: The required suffix.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereEndsWith(field entity.Field[T], suffix string) *QueryBuilder[T] {
+	return qb.Where(field.EndsWith(suffix))
+}
+
+// WhereContains adds a WHERE condition filtering results where a field contains the given substring.
+//
+// Params:
+//   - field: The entity field to filter by.
+//   - substr: The required substring.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereContains(field entity.Field[T], substr string) *QueryBuilder[T] {
+	return qb.Where(field.Contains(substr))
+}
+
+// WhereIsNull adds a WHERE condition filtering results where a field is NULL.
+//
+// Params:
+//   - field: The entity field to filter by.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereIsNull(field entity.Field[T]) *QueryBuilder[T] {
+	return qb.Where(field.IsNull())
+}
+
+// WhereIsNotNull adds a WHERE condition filtering results where a field is not NULL.
+//
+// Params:
+//   - field: The entity field to filter by.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereIsNotNull(field entity.Field[T]) *QueryBuilder[T] {
+	return qb.Where(field.IsNotNull())
+}
+
+// WhereAny adds a WHERE condition requiring at least one of the given
+// conditions to hold, rendered as "(cond1 OR cond2 OR ...)".
+//
+// Params:
+//   - conds: The candidate conditions, e.g. built from entity.Field methods.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereAny(conds ...entity.Condition) *QueryBuilder[T] {
+	return qb.Where(entity.Or(conds...))
+}
+
+// WhereAll adds a WHERE condition requiring every one of the given
+// conditions to hold, rendered as "(cond1 AND cond2 AND ...)". Group it in
+// its own parentheses so it combines correctly with other conditions
+// added via WhereAny.
+//
+// Params:
+//   - conds: The conditions that must all hold.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) WhereAll(conds ...entity.Condition) *QueryBuilder[T] {
+	return qb.Where(entity.And(conds...))
 }
 
 // OrderByASC adds an ORDER BY clause sorting results in ascending order.
@@ -112,7 +389,7 @@ func (qb *QueryBuilder[T]) WhereEqual(field entity.Field[T], value interface{})
 // Returns:
 //   - *QueryBuilder[T]: The updated QueryBuilder instance.
 func (qb *QueryBuilder[T]) OrderByASC(field entity.Field[T]) *QueryBuilder[T] {
-	qb.orderBy = append(qb.orderBy, string(field)+" ASC")
+	qb.orderBy = append(qb.orderBy, orderSpec{field: string(field), direction: "ASC"})
 	return qb
 }
 
@@ -124,7 +401,7 @@ func (qb *QueryBuilder[T]) OrderByASC(field entity.Field[T]) *QueryBuilder[T] {
 // Returns:
 //   - *QueryBuilder[T]: The updated QueryBuilder instance.
 func (qb *QueryBuilder[T]) OrderByDESC(field entity.Field[T]) *QueryBuilder[T] {
-	qb.orderBy = append(qb.orderBy, string(field)+" DESC")
+	qb.orderBy = append(qb.orderBy, orderSpec{field: string(field), direction: "DESC"})
 	return qb
 }
 
@@ -141,6 +418,30 @@ func (qb *QueryBuilder[T]) Join(table string, onCondition string) *QueryBuilder[
 	return qb
 }
 
+// Include records a relation, declared on T via a `rel:"..."` struct tag,
+// to eager-load alongside the primary query. GenericRepository.Query reads
+// Includes() after running the built query and fires one batched
+// follow-up query per relation, instead of one query per row.
+//
+// Params:
+//   - field: The relation field to include.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) Include(field entity.Field[T]) *QueryBuilder[T] {
+	qb.includes = append(qb.includes, string(field))
+	return qb
+}
+
+// Includes returns the relation field names recorded via Include, in the
+// order they were added.
+//
+// Returns:
+//   - []string: The recorded relation field names.
+func (qb *QueryBuilder[T]) Includes() []string {
+	return qb.includes
+}
+
 // Limit sets the maximum number of rows to be returned.
 //
 // Params:
@@ -153,7 +454,47 @@ func (qb *QueryBuilder[T]) Limit(limit int) *QueryBuilder[T] {
 	return qb
 }
 
-// Build generates the final SQL query string and its arguments.
+// Paginate limits the SELECT to one page of pageSize rows starting at
+// page (1-indexed), rendered through the dialect's LimitOffset instead of
+// Limit's plain LIMIT. GenericRepository.List reads Page/PageSize back to
+// populate result.Paginated's metadata.
+//
+// Params:
+//   - page: The 1-indexed page number to fetch.
+//   - pageSize: The number of rows per page.
+//
+// Returns:
+//   - *QueryBuilder[T]: The updated QueryBuilder instance.
+func (qb *QueryBuilder[T]) Paginate(page, pageSize int) *QueryBuilder[T] {
+	qb.paginate = true
+	qb.page = page
+	qb.pageSize = pageSize
+	return qb
+}
+
+// Page returns the page number set via Paginate, or 0 if Paginate wasn't called.
+func (qb *QueryBuilder[T]) Page() int {
+	return qb.page
+}
+
+// PageSize returns the page size set via Paginate, or 0 if Paginate wasn't called.
+func (qb *QueryBuilder[T]) PageSize() int {
+	return qb.pageSize
+}
+
+// selectConditions returns qb's WHERE conditions for a SELECT or COUNT
+// query, with an automatic "<delete_at> IS NULL" filter appended when the
+// entity is soft-deletable, so a row Delete only marked is never
+// resurfaced by a read.
+func (qb *QueryBuilder[T]) selectConditions(schema utils.Schema) []entity.Condition {
+	if !schema.IsSoftDelete() {
+		return qb.whereConditions
+	}
+	return append(append([]entity.Condition{}, qb.whereConditions...), entity.Field[T](schema.DeleteAtCol).IsNull())
+}
+
+// Build generates the final SQL query string and its arguments, rendered
+// for the builder's Dialect.
 //
 // Returns:
 //   - string: The generated SQL query.
@@ -161,48 +502,87 @@ func (qb *QueryBuilder[T]) Limit(limit int) *QueryBuilder[T] {
 func (qb *QueryBuilder[T]) Build() (string, []interface{}) {
 	var sqlBuilder strings.Builder
 
-	var entityInstance T
-	typeName := reflect.TypeOf(entityInstance).Name()
-	tableName := strings.ToLower(typeName)
+	schema := utils.SchemaOf[T]()
+	tableName := qb.dialect.QuoteIdentifier(schema.TableName)
 
 	const whereClause = " WHERE "
 
+	pw := &paramWriter{dialect: qb.dialect}
+
 	if len(qb.insertFields) > 0 {
 		// INSERT INTO table (col1, col2) VALUES (?, ?)
+		columns := make([]string, len(qb.insertFields))
+		for i, f := range qb.insertFields {
+			columns[i] = qb.dialect.QuoteIdentifier(f)
+		}
 		sqlBuilder.WriteString("INSERT INTO " + tableName + " (")
-		sqlBuilder.WriteString(strings.Join(qb.insertFields, ", "))
+		sqlBuilder.WriteString(strings.Join(columns, ", "))
 		sqlBuilder.WriteString(") VALUES (")
-		placeholders := make([]string, len(qb.insertFields))
-		for i := range placeholders {
-			placeholders[i] = "?"
+		placeholders := make([]string, len(qb.insertValues))
+		for i, v := range qb.insertValues {
+			placeholders[i] = pw.add(v)
 		}
 		sqlBuilder.WriteString(strings.Join(placeholders, ", "))
 		sqlBuilder.WriteString(")")
-		return sqlBuilder.String(), qb.insertValues
+		if qb.dialect.SupportsReturning() && len(schema.PrimaryKeys) > 0 {
+			sqlBuilder.WriteString(" RETURNING " + qb.dialect.QuoteIdentifier(schema.PrimaryKeys[0].ColumnName))
+		}
+		return sqlBuilder.String(), pw.args
 	}
 
 	if len(qb.updateFields) > 0 {
 		// UPDATE table SET col1 = ?, col2 = ? WHERE ...
+		sets := make([]string, len(qb.updateFields))
+		for i, f := range qb.updateFields {
+			sets[i] = qb.dialect.QuoteIdentifier(f) + " = " + pw.add(qb.updateValues[i])
+		}
 		sqlBuilder.WriteString("UPDATE " + tableName + " SET ")
-		sqlBuilder.WriteString(strings.Join(qb.updateFields, ", "))
+		sqlBuilder.WriteString(strings.Join(sets, ", "))
 
-		if len(qb.whereClauses) > 0 {
-			sqlBuilder.WriteString(whereClause + strings.Join(qb.whereClauses, " AND "))
+		whereSQL := renderWhere(qb.whereConditions, pw)
+		if whereSQL != "" {
+			sqlBuilder.WriteString(whereClause + whereSQL)
+		}
+		if qb.dialect.SupportsReturning() && len(schema.PrimaryKeys) > 0 {
+			sqlBuilder.WriteString(" RETURNING " + qb.dialect.QuoteIdentifier(schema.PrimaryKeys[0].ColumnName))
 		}
-		return sqlBuilder.String(), qb.whereArgs
+		return sqlBuilder.String(), pw.args
 	}
 
-	if len(qb.whereClauses) > 0 && len(qb.insertFields) == 0 {
+	if qb.softDeleteCol != "" {
+		// UPDATE table SET delete_at = <dialect's current timestamp> WHERE ...
+		whereSQL := renderWhere(qb.whereConditions, pw)
+		sqlBuilder.WriteString("UPDATE " + tableName + " SET ")
+		sqlBuilder.WriteString(qb.dialect.QuoteIdentifier(qb.softDeleteCol) + " = " + qb.dialect.CurrentTimestamp())
+		if whereSQL != "" {
+			sqlBuilder.WriteString(whereClause + whereSQL)
+		}
+		return sqlBuilder.String(), pw.args
+	}
+
+	if qb.isDelete {
 		// DELETE FROM table WHERE ...
+		whereSQL := renderWhere(qb.whereConditions, pw)
 		sqlBuilder.WriteString("DELETE FROM " + tableName)
-		sqlBuilder.WriteString(whereClause + strings.Join(qb.whereClauses, " AND "))
-		return sqlBuilder.String(), qb.whereArgs
+		if whereSQL != "" {
+			sqlBuilder.WriteString(whereClause + whereSQL)
+		}
+		return sqlBuilder.String(), pw.args
 	}
 
 	// SELECT * FROM table
+	whereSQL := renderWhere(qb.selectConditions(schema), pw)
+
 	sqlBuilder.WriteString("SELECT ")
+	if !qb.paginate && qb.limit > 0 {
+		sqlBuilder.WriteString(qb.dialect.SelectLimit(qb.limit))
+	}
 	if len(qb.selectFields) > 0 {
-		sqlBuilder.WriteString(strings.Join(qb.selectFields, ", "))
+		columns := make([]string, len(qb.selectFields))
+		for i, f := range qb.selectFields {
+			columns[i] = qb.dialect.QuoteIdentifier(f)
+		}
+		sqlBuilder.WriteString(strings.Join(columns, ", "))
 	} else {
 		sqlBuilder.WriteString("*")
 	}
@@ -212,18 +592,56 @@ func (qb *QueryBuilder[T]) Build() (string, []interface{}) {
 		sqlBuilder.WriteString(" " + strings.Join(qb.joins, " "))
 	}
 
-	if len(qb.whereClauses) > 0 {
-		sqlBuilder.WriteString(whereClause + strings.Join(qb.whereClauses, " AND "))
+	if whereSQL != "" {
+		sqlBuilder.WriteString(whereClause + whereSQL)
 	}
 
 	if len(qb.orderBy) > 0 {
-		sqlBuilder.WriteString(" ORDER BY " + strings.Join(qb.orderBy, ", "))
+		orderClauses := make([]string, len(qb.orderBy))
+		for i, o := range qb.orderBy {
+			orderClauses[i] = qb.dialect.QuoteIdentifier(o.field) + " " + o.direction
+		}
+		sqlBuilder.WriteString(" ORDER BY " + strings.Join(orderClauses, ", "))
+	}
+
+	if qb.paginate {
+		offset := (qb.page - 1) * qb.pageSize
+		if offset < 0 {
+			offset = 0
+		}
+		sqlBuilder.WriteString(" " + qb.dialect.LimitOffset(pw, qb.pageSize, offset))
+	} else if qb.limit > 0 {
+		if frag := qb.dialect.TrailingLimit(pw, qb.limit); frag != "" {
+			sqlBuilder.WriteString(" " + frag)
+		}
+	}
+
+	return sqlBuilder.String(), pw.args
+}
+
+// BuildCount generates a SELECT COUNT(*) query sharing this builder's
+// JOINs and WHERE conditions, for computing a paginated result's total
+// row count alongside Build's SELECT.
+//
+// Returns:
+//   - string: The generated SQL query.
+//   - []interface{}: The slice of arguments to be used with the query.
+func (qb *QueryBuilder[T]) BuildCount() (string, []interface{}) {
+	schema := utils.SchemaOf[T]()
+	tableName := qb.dialect.QuoteIdentifier(schema.TableName)
+
+	pw := &paramWriter{dialect: qb.dialect}
+	var sqlBuilder strings.Builder
+
+	sqlBuilder.WriteString("SELECT COUNT(*) FROM " + tableName)
+	if len(qb.joins) > 0 {
+		sqlBuilder.WriteString(" " + strings.Join(qb.joins, " "))
 	}
 
-	if qb.limit > 0 {
-		sqlBuilder.WriteString(" LIMIT ?")
-		qb.whereArgs = append(qb.whereArgs, qb.limit)
+	whereSQL := renderWhere(qb.selectConditions(schema), pw)
+	if whereSQL != "" {
+		sqlBuilder.WriteString(" WHERE " + whereSQL)
 	}
 
-	return sqlBuilder.String(), qb.whereArgs
+	return sqlBuilder.String(), pw.args
 }
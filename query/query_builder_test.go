@@ -0,0 +1,92 @@
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/binpqh/GoBase/entity"
+)
+
+// testUser is a soft-deletable entity used only by this package's tests.
+type testUser struct {
+	entity.Entity[int, int]
+	Name string `db:"name"`
+}
+
+// TestBuildLimitAcrossDialects covers the exact shape GetByID builds
+// (WhereEqual + Limit(1), no pagination) across every supported dialect,
+// asserting SQL Server renders a leading TOP instead of an invalid
+// trailing LIMIT.
+func TestBuildLimitAcrossDialects(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		want    string
+	}{
+		{"mysql", MySQLDialect{}, "LIMIT ?"},
+		{"postgres", PostgresDialect{}, "LIMIT $2"},
+		{"sqlite", SQLiteDialect{}, "LIMIT ?"},
+		{"sqlserver", SQLServerDialect{}, "TOP (1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qb := NewQueryBuilderFor[testUser](tt.dialect).
+				WhereEqual(entity.Field[testUser]("id"), 1).
+				Limit(1)
+
+			sql, _ := qb.Build()
+
+			if !strings.Contains(sql, tt.want) {
+				t.Errorf("Build() = %q, want it to contain %q", sql, tt.want)
+			}
+			if strings.Contains(sql, "LIMIT @p") {
+				t.Errorf("Build() = %q, emitted invalid T-SQL LIMIT placeholder", sql)
+			}
+		})
+	}
+}
+
+// TestBuildExcludesSoftDeletedRows verifies a plain SELECT automatically
+// filters out soft-deleted rows for a soft-deletable entity.
+func TestBuildExcludesSoftDeletedRows(t *testing.T) {
+	qb := NewQueryBuilderFor[testUser](PostgresDialect{})
+	sql, _ := qb.Build()
+
+	if !strings.Contains(sql, `"DeleteAt" IS NULL`) {
+		t.Errorf("Build() = %q, want it to filter out soft-deleted rows", sql)
+	}
+}
+
+// TestBuildCountExcludesSoftDeletedRows mirrors
+// TestBuildExcludesSoftDeletedRows for BuildCount, which backs a
+// paginated result's total row count.
+func TestBuildCountExcludesSoftDeletedRows(t *testing.T) {
+	qb := NewQueryBuilderFor[testUser](PostgresDialect{})
+	sql, _ := qb.BuildCount()
+
+	if !strings.Contains(sql, `"DeleteAt" IS NULL`) {
+		t.Errorf("BuildCount() = %q, want it to filter out soft-deleted rows", sql)
+	}
+}
+
+// TestBuildDeleteDoesNotSelfFilter verifies that Delete (the soft-delete
+// UPDATE) applies the caller's own WHERE conditions as-is, without the
+// automatic "DeleteAt IS NULL" filter that reads get, since an explicit
+// Delete call should still be able to target an already soft-deleted row.
+func TestBuildDeleteDoesNotSelfFilter(t *testing.T) {
+	qb := NewQueryBuilderFor[testUser](PostgresDialect{}).
+		Delete().
+		WhereEqual(entity.Field[testUser]("id"), 1)
+
+	sql, args := qb.Build()
+
+	if !strings.HasPrefix(sql, `UPDATE "test_users" SET "DeleteAt" = NOW()`) {
+		t.Errorf("Build() = %q, want a soft-delete UPDATE stamping DeleteAt", sql)
+	}
+	if strings.Contains(sql, "IS NULL") {
+		t.Errorf("Build() = %q, soft-delete UPDATE should not self-filter already-deleted rows", sql)
+	}
+	if len(args) != 1 || args[0] != 1 {
+		t.Errorf("Build() args = %v, want [1]", args)
+	}
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+// DB is the subset of *sql.DB and *sql.Tx that GenericRepository needs to
+// run queries, so it can execute against a plain connection pool or an
+// in-flight transaction (see the tx package) without caring which.
+type DB interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
@@ -1,132 +1,207 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"reflect"
 
 	"github.com/binpqh/GoBase/entity"
 	"github.com/binpqh/GoBase/query"
+	"github.com/binpqh/GoBase/repository/tx"
+	"github.com/binpqh/GoBase/utils"
 )
 
 // Repository defines generic CRUD operations.
 type Repository[TEntity any, TKey any] interface {
-	GetByID(id TKey) (*TEntity, error)                                // Fetch entity by ID
-	GetAll() ([]TEntity, error)                                       // Fetch all entities
-	GetByExpression(expression func(TEntity) bool) ([]TEntity, error) // Fetch entities by condition
-	Create(entity *TEntity) error                                     // Insert new entity
-	Update(entity *TEntity) error                                     // Update entity by ID
-	Delete(id TKey) error                                             // Delete entity by ID
+	GetByID(ctx context.Context, id TKey) (*TEntity, error)                                // Fetch entity by ID
+	GetAll(ctx context.Context) ([]TEntity, error)                                         // Fetch all entities
+	GetByExpression(ctx context.Context, expression func(TEntity) bool) ([]TEntity, error) // Fetch entities by condition
+	Create(ctx context.Context, entity *TEntity) error                                     // Insert new entity
+	Update(ctx context.Context, entity *TEntity) error                                     // Update entity by ID
+	Delete(ctx context.Context, id TKey) error                                             // Delete entity by ID
 }
 
 // GenericRepository provides default implementations of Repository.
 type GenericRepository[T any, TKey any] struct {
-	db *sql.DB
+	db      DB
+	dialect query.Dialect
 }
 
-// NewGenericRepository creates a new instance of GenericRepository.
+// NewGenericRepository creates a new instance of GenericRepository
+// targeting MySQL. Use NewGenericRepositoryFor to target a different
+// database engine.
 func NewGenericRepository[T any, TKey any](db *sql.DB) *GenericRepository[T, TKey] {
-	return &GenericRepository[T, TKey]{db: db}
+	return NewGenericRepositoryFor[T, TKey](db, query.MySQLDialect{})
+}
+
+// NewGenericRepositoryFor creates a new instance of GenericRepository that
+// builds and executes queries for the given SQL dialect. db is the
+// fallback connection pool used when a call's context carries no active
+// transaction; see tx.WithTx.
+func NewGenericRepositoryFor[T any, TKey any](db DB, dialect query.Dialect) *GenericRepository[T, TKey] {
+	return &GenericRepository[T, TKey]{db: db, dialect: dialect}
+}
+
+// conn resolves the connection a call should run against: the active
+// transaction on ctx if tx.WithTx put one there, otherwise r.db.
+func (r *GenericRepository[T, TKey]) conn(ctx context.Context) DB {
+	if sqlTx, ok := tx.FromContext(ctx); ok {
+		return sqlTx
+	}
+	return r.db
 }
 
 // GetByID retrieves an entity by its primary key.
 //
 // Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
 //   - id: Primary key value.
 //
 // Returns:
 //   - *T: The entity if found.
 //   - error: Error if the query fails or no entity is found.
-func (r *GenericRepository[T, TKey]) GetByID(id TKey) (*T, error) {
-	entityInstance := new(T)
-
-	qb := query.NewQueryBuilder[T]().
-		WhereEqual(entity.Field[T]("ID"), id).
+func (r *GenericRepository[T, TKey]) GetByID(ctx context.Context, id TKey) (*T, error) {
+	schema := utils.SchemaOf[T]()
+	qb := query.NewQueryBuilderFor[T](r.dialect).
+		WhereEqual(entity.Field[T](schema.PrimaryKeys[0].ColumnName), id).
 		Limit(1)
 
 	sqlQuery, args := qb.Build()
 
-	row := r.db.QueryRow(sqlQuery, args...)
-	err := row.Scan(entityInstance)
+	rows, err := r.conn(ctx).QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, err
 	}
-	return entityInstance, nil
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, sql.ErrNoRows
+	}
+
+	entityInstance, err := ScanOne[T](rows)
+	if err != nil {
+		return nil, err
+	}
+	return &entityInstance, nil
 }
 
 // GetAll retrieves all records of type T.
 //
+// Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
+//
 // Returns:
 //   - []T: A slice of entities.
 //   - error: Error if the query fails.
-func (r *GenericRepository[T, TKey]) GetAll() ([]T, error) {
-	var results []T
-
-	qb := query.NewQueryBuilder[T]() // SELECT * FROM table
-	sql, args := qb.Build()
+func (r *GenericRepository[T, TKey]) GetAll(ctx context.Context) ([]T, error) {
+	qb := query.NewQueryBuilderFor[T](r.dialect) // SELECT * FROM table
+	sqlQuery, args := qb.Build()
 
-	rows, err := r.db.Query(sql, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	for rows.Next() {
-		var entity T
-		if err := rows.Scan(&entity); err != nil {
-			return nil, err
-		}
-		results = append(results, entity)
-	}
-	return results, nil
+	return ScanAll[T](rows)
 }
 
-// Create inserts a new entity into the database.
+// Create inserts a new entity into the database. When the dialect
+// supports RETURNING, the generated primary key is scanned back into the
+// entity's ID field.
 //
 // Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
 //   - entity: The entity to be inserted.
 //
 // Returns:
 //   - error: Error if insertion fails.
-func (r *GenericRepository[T, TKey]) Create(entity *T) error {
-	qb := query.NewQueryBuilder[T]().Insert(*entity)
-	sql, args := qb.Build()
+func (r *GenericRepository[T, TKey]) Create(ctx context.Context, entity *T) error {
+	qb := query.NewQueryBuilderFor[T](r.dialect).Insert(*entity)
+	sqlQuery, args := qb.Build()
 
-	_, err := r.db.Exec(sql, args...)
-	return err
+	conn := r.conn(ctx)
+
+	if !r.dialect.SupportsReturning() {
+		_, err := conn.ExecContext(ctx, sqlQuery, args...)
+		return err
+	}
+
+	rows, err := conn.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+
+	schema := utils.SchemaOf[T]()
+	idField := reflect.ValueOf(entity).Elem().FieldByIndex(schema.PrimaryKeys[0].Index)
+	return rows.Scan(idField.Addr().Interface())
 }
 
 // Update modifies an existing entity based on its ID.
 //
 // Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
 //   - entity: The entity with updated values.
 //
 // Returns:
 //   - error: Error if the update fails.
-func (r *GenericRepository[T, TKey]) Update(ent *T) error {
-	idValue := reflect.ValueOf(ent).Elem().FieldByName("ID").Interface()
+func (r *GenericRepository[T, TKey]) Update(ctx context.Context, ent *T) error {
+	schema := utils.SchemaOf[T]()
+	idValue := reflect.ValueOf(ent).Elem().FieldByIndex(schema.PrimaryKeys[0].Index).Interface()
 
-	qb := query.NewQueryBuilder[T]().
+	qb := query.NewQueryBuilderFor[T](r.dialect).
 		Update(*ent).
-		WhereEqual(entity.Field[T]("ID"), idValue)
+		WhereEqual(entity.Field[T](schema.PrimaryKeys[0].ColumnName), idValue)
 
-	sql, args := qb.Build()
-	_, err := r.db.Exec(sql, args...)
-	return err
+	sqlQuery, args := qb.Build()
+	conn := r.conn(ctx)
+
+	if !r.dialect.SupportsReturning() {
+		_, err := conn.ExecContext(ctx, sqlQuery, args...)
+		return err
+	}
+
+	rows, err := conn.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var discarded interface{}
+		if err := rows.Scan(&discarded); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
 }
 
 // Delete removes an entity from the database by its ID.
 //
 // Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
 //   - id: Primary key value of the entity to delete.
 //
 // Returns:
 //   - error: Error if deletion fails.
-func (r *GenericRepository[T, TKey]) Delete(id TKey) error {
-	qb := query.NewQueryBuilder[T]().
+func (r *GenericRepository[T, TKey]) Delete(ctx context.Context, id TKey) error {
+	schema := utils.SchemaOf[T]()
+	qb := query.NewQueryBuilderFor[T](r.dialect).
 		Delete().
-		WhereEqual(entity.Field[T]("ID"), id)
+		WhereEqual(entity.Field[T](schema.PrimaryKeys[0].ColumnName), id)
 
-	sql, args := qb.Build()
-	_, err := r.db.Exec(sql, args...)
+	sqlQuery, args := qb.Build()
+	_, err := r.conn(ctx).ExecContext(ctx, sqlQuery, args...)
 	return err
 }
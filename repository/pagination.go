@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/binpqh/GoBase/query"
+	"github.com/binpqh/GoBase/result"
+)
+
+// List runs qb's built SELECT, via Query, alongside a COUNT(*) query
+// sharing the same WHERE conditions and JOINs, returning one page of
+// results and the total row count. qb must have been configured with
+// Paginate.
+//
+// Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
+//   - qb: The paginated query to run.
+//
+// Returns:
+//   - result.Paginated[T]: The page of results and pagination metadata.
+//   - error: Error if either query fails.
+func (r *GenericRepository[T, TKey]) List(ctx context.Context, qb *query.QueryBuilder[T]) (result.Paginated[T], error) {
+	items, err := r.Query(ctx, qb)
+	if err != nil {
+		return result.Paginated[T]{}, err
+	}
+
+	countQuery, countArgs := qb.BuildCount()
+
+	rows, err := r.conn(ctx).QueryContext(ctx, countQuery, countArgs...)
+	if err != nil {
+		return result.Paginated[T]{}, err
+	}
+	defer rows.Close()
+
+	var total int
+	if rows.Next() {
+		if err := rows.Scan(&total); err != nil {
+			return result.Paginated[T]{}, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return result.Paginated[T]{}, err
+	}
+
+	return result.NewPaginated(items, qb.Page(), qb.PageSize(), total), nil
+}
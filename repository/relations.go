@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/binpqh/GoBase/query"
+	"github.com/binpqh/GoBase/utils"
+)
+
+// Query runs qb's built SELECT and scans the results, then eager-loads any
+// relations recorded on qb via Include: one batched "WHERE fk IN (...)"
+// query per relation, with matching child rows stitched back onto their
+// parent, instead of one query per parent row.
+//
+// Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
+//   - qb: The query to run, optionally carrying relations added via Include.
+//
+// Returns:
+//   - []T: The populated entities, with requested relations hydrated.
+//   - error: Error if the query or any relation load fails.
+func (r *GenericRepository[T, TKey]) Query(ctx context.Context, qb *query.QueryBuilder[T]) ([]T, error) {
+	sqlQuery, args := qb.Build()
+
+	rows, err := r.conn(ctx).QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results, err := ScanAll[T](rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.hydrateRelations(ctx, results, qb.Includes()); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// LoadRelation lazily hydrates a single relation field on parent, for
+// on-demand loading outside of Query's eager Include path.
+//
+// Params:
+//   - ctx: Context governing cancellation and the active transaction, if any.
+//   - parent: The entity whose relation field should be populated.
+//   - name: The relation's Go field name, as declared via a `rel:"..."` tag.
+//
+// Returns:
+//   - error: Error if name isn't a declared relation of T, or the query fails.
+func (r *GenericRepository[T, TKey]) LoadRelation(ctx context.Context, parent *T, name string) error {
+	schema := utils.SchemaOf[T]()
+	rel, ok := schema.Relation(name)
+	if !ok {
+		return fmt.Errorf("repository: %q is not a declared relation of %T", name, *parent)
+	}
+
+	results := []T{*parent}
+	if err := r.hydrateRelation(ctx, results, rel); err != nil {
+		return err
+	}
+	*parent = results[0]
+	return nil
+}
+
+// hydrateRelations loads each named relation in turn, in the order they
+// were recorded. Unknown relation names are silently skipped, mirroring
+// how an unmatched column is discarded when scanning.
+func (r *GenericRepository[T, TKey]) hydrateRelations(ctx context.Context, results []T, includes []string) error {
+	if len(includes) == 0 || len(results) == 0 {
+		return nil
+	}
+
+	schema := utils.SchemaOf[T]()
+	for _, name := range includes {
+		rel, ok := schema.Relation(name)
+		if !ok {
+			continue
+		}
+		if err := r.hydrateRelation(ctx, results, rel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hydrateRelation loads one relation for every entity in results with a
+// single batched query, then stitches matching child rows back onto their
+// owning parent by comparing the parent's key column against the child's
+// matching column.
+func (r *GenericRepository[T, TKey]) hydrateRelation(ctx context.Context, results []T, rel utils.Relation) error {
+	schema := utils.SchemaOf[T]()
+	targetSchema := utils.SchemaForType(rel.Target)
+
+	var parentKeyCol, childMatchCol string
+	if rel.Kind == utils.RelationBelongsTo {
+		parentKeyCol, childMatchCol = rel.ForeignKeyCol, rel.ReferenceCol
+	} else {
+		parentKeyCol, childMatchCol = rel.ReferenceCol, rel.ForeignKeyCol
+	}
+
+	parentKeyColumn, ok := schema.Column(parentKeyCol)
+	if !ok {
+		return fmt.Errorf("repository: relation %q references unknown column %q", rel.FieldName, parentKeyCol)
+	}
+	childMatchColumn, ok := targetSchema.Column(childMatchCol)
+	if !ok {
+		return fmt.Errorf("repository: relation %q references unknown column %q", rel.FieldName, childMatchCol)
+	}
+
+	var keys []interface{}
+	seen := make(map[interface{}]bool)
+	for i := range results {
+		key := reflect.ValueOf(results[i]).FieldByIndex(parentKeyColumn.Index).Interface()
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	children, err := r.queryRelationTargets(ctx, rel.Target, targetSchema, childMatchColumn, keys)
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[interface{}][]reflect.Value, len(keys))
+	for _, child := range children {
+		key := child.FieldByIndex(childMatchColumn.Index).Interface()
+		byKey[key] = append(byKey[key], child)
+	}
+
+	for i := range results {
+		parentValue := reflect.ValueOf(&results[i]).Elem()
+		key := parentValue.FieldByIndex(parentKeyColumn.Index).Interface()
+		matches := byKey[key]
+		field := parentValue.FieldByIndex(rel.Index)
+
+		switch field.Kind() {
+		case reflect.Slice:
+			slice := reflect.MakeSlice(field.Type(), 0, len(matches))
+			for _, match := range matches {
+				slice = reflect.Append(slice, match)
+			}
+			field.Set(slice)
+		case reflect.Ptr:
+			if len(matches) > 0 {
+				ptr := reflect.New(field.Type().Elem())
+				ptr.Elem().Set(matches[0])
+				field.Set(ptr)
+			}
+		default:
+			if len(matches) > 0 {
+				field.Set(matches[0])
+			}
+		}
+	}
+	return nil
+}
+
+// queryRelationTargets runs one batched "WHERE matchColumn IN (...)"
+// query against the relation's target table and scans the matching rows.
+func (r *GenericRepository[T, TKey]) queryRelationTargets(ctx context.Context, targetType reflect.Type, targetSchema utils.Schema, matchColumn utils.Column, keys []interface{}) ([]reflect.Value, error) {
+	placeholders := make([]string, len(keys))
+	for i := range keys {
+		placeholders[i] = r.dialect.Placeholder(i + 1)
+	}
+
+	sqlQuery := fmt.Sprintf(
+		"SELECT * FROM %s WHERE %s IN (%s)",
+		r.dialect.QuoteIdentifier(targetSchema.TableName),
+		r.dialect.QuoteIdentifier(matchColumn.ColumnName),
+		strings.Join(placeholders, ", "),
+	)
+
+	rows, err := r.conn(ctx).QueryContext(ctx, sqlQuery, keys...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanRowsReflect(rows, targetType)
+}
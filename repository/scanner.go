@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"database/sql"
+	"reflect"
+	"time"
+
+	"github.com/binpqh/GoBase/utils"
+)
+
+// ScanOne scans the row rows is currently positioned on into a new T,
+// matching result columns against T's Schema (see utils.SchemaOf) rather
+// than assuming column order. Callers must have already advanced rows to
+// a row via rows.Next().
+//
+// Params:
+//   - rows: The result set, positioned on the row to scan.
+//
+// Returns:
+//   - T: The populated entity.
+//   - error: Error if column resolution or the underlying Scan fails.
+func ScanOne[T any](rows *sql.Rows) (T, error) {
+	var result T
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return result, err
+	}
+
+	targets, bindings := scanTargets[T](reflect.ValueOf(&result).Elem(), columns)
+	if err := rows.Scan(targets...); err != nil {
+		return result, err
+	}
+	for _, bind := range bindings {
+		bind()
+	}
+	return result, nil
+}
+
+// ScanAll scans every remaining row of rows into a []T, using the same
+// column-to-field resolution as ScanOne.
+//
+// Params:
+//   - rows: The result set to drain.
+//
+// Returns:
+//   - []T: The populated entities, in row order.
+//   - error: Error if column resolution or any row's Scan fails.
+func ScanAll[T any](rows *sql.Rows) ([]T, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []T
+	for rows.Next() {
+		var item T
+
+		targets, bindings := scanTargets[T](reflect.ValueOf(&item).Elem(), columns)
+		if err := rows.Scan(targets...); err != nil {
+			return nil, err
+		}
+		for _, bind := range bindings {
+			bind()
+		}
+		results = append(results, item)
+	}
+	return results, rows.Err()
+}
+
+// scanTargets builds, in the order reported by rows.Columns(), the
+// addressable destinations rows.Scan should populate. It returns the
+// targets alongside a list of bindings to run after Scan succeeds, which
+// copy NULL-able sql.Null* scratch values back into their destination
+// fields. A column with no matching Schema field is discarded.
+func scanTargets[T any](dest reflect.Value, columns []string) ([]interface{}, []func()) {
+	return scanTargetsForSchema(dest, utils.SchemaOf[T](), columns)
+}
+
+// scanRowsReflect scans every remaining row of rows into a slice of
+// elemType, using the same column-to-field resolution as ScanAll. It
+// exists for callers that only know the destination type at runtime, such
+// as a relation's target entity.
+func scanRowsReflect(rows *sql.Rows, elemType reflect.Type) ([]reflect.Value, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	schema := utils.SchemaForType(elemType)
+
+	var results []reflect.Value
+	for rows.Next() {
+		item := reflect.New(elemType).Elem()
+
+		targets, bindings := scanTargetsForSchema(item, schema, columns)
+		if err := rows.Scan(targets...); err != nil {
+			return nil, err
+		}
+		for _, bind := range bindings {
+			bind()
+		}
+		results = append(results, item)
+	}
+	return results, rows.Err()
+}
+
+// scanTargetsForSchema is the schema-driven core shared by scanTargets and
+// scanRowsReflect.
+func scanTargetsForSchema(dest reflect.Value, schema utils.Schema, columns []string) ([]interface{}, []func()) {
+	byColumn := make(map[string]utils.Column, len(schema.Columns))
+	for _, col := range schema.Columns {
+		byColumn[col.ColumnName] = col
+	}
+
+	targets := make([]interface{}, len(columns))
+	var bindings []func()
+
+	for i, name := range columns {
+		col, ok := byColumn[name]
+		if !ok {
+			var discard interface{}
+			targets[i] = &discard
+			continue
+		}
+
+		field := dest.FieldByIndex(col.Index)
+		target, bind := scanTarget(field)
+		targets[i] = target
+		if bind != nil {
+			bindings = append(bindings, bind)
+		}
+	}
+	return targets, bindings
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// scanTarget returns the address rows.Scan should write into for field,
+// and an optional binding to run afterwards. sql.Scanner implementers are
+// scanned directly; pointer fields scan into a sql.Null* scratch value so
+// a NULL column leaves the field nil instead of erroring.
+func scanTarget(field reflect.Value) (interface{}, func()) {
+	if field.CanAddr() {
+		if scanner, ok := field.Addr().Interface().(sql.Scanner); ok {
+			return scanner, nil
+		}
+	}
+
+	if field.Kind() != reflect.Ptr {
+		return field.Addr().Interface(), nil
+	}
+
+	elemType := field.Type().Elem()
+	switch {
+	case elemType.Kind() == reflect.String:
+		var ns sql.NullString
+		return &ns, func() {
+			if ns.Valid {
+				field.Set(reflect.ValueOf(&ns.String))
+			}
+		}
+	case elemType.Kind() == reflect.Bool:
+		var nb sql.NullBool
+		return &nb, func() {
+			if nb.Valid {
+				field.Set(reflect.ValueOf(&nb.Bool))
+			}
+		}
+	case elemType.Kind() == reflect.Float32 || elemType.Kind() == reflect.Float64:
+		var nf sql.NullFloat64
+		return &nf, func() {
+			if nf.Valid {
+				v := reflect.New(elemType)
+				v.Elem().SetFloat(nf.Float64)
+				field.Set(v)
+			}
+		}
+	case elemType == timeType:
+		var nt sql.NullTime
+		return &nt, func() {
+			if nt.Valid {
+				field.Set(reflect.ValueOf(&nt.Time))
+			}
+		}
+	case elemType.Kind() == reflect.Int || elemType.Kind() == reflect.Int32 || elemType.Kind() == reflect.Int64:
+		var ni sql.NullInt64
+		return &ni, func() {
+			if ni.Valid {
+				v := reflect.New(elemType)
+				v.Elem().SetInt(ni.Int64)
+				field.Set(v)
+			}
+		}
+	default:
+		// No known nullable fallback: scan straight into a freshly
+		// allocated value of the pointed-to type.
+		v := reflect.New(elemType)
+		field.Set(v)
+		return v.Interface(), nil
+	}
+}
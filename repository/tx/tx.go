@@ -0,0 +1,61 @@
+// Package tx implements a unit-of-work helper that lets multiple
+// GenericRepository calls share a single database transaction through
+// context.Context propagation.
+package tx
+
+import (
+	"context"
+	"database/sql"
+)
+
+type ctxKey struct{}
+
+// UnitOfWork begins and completes transactions against a single *sql.DB.
+type UnitOfWork struct {
+	db *sql.DB
+}
+
+// NewUnitOfWork creates a UnitOfWork bound to db.
+func NewUnitOfWork(db *sql.DB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// WithTx begins a transaction and runs fn with it attached to ctx, so any
+// GenericRepository whose conn is resolved from that ctx reuses the same
+// transaction. The transaction is committed if fn returns nil, and rolled
+// back if fn returns an error or panics.
+//
+// Params:
+//   - ctx: The parent context; fn receives a derived context carrying the transaction.
+//   - fn: The unit of work to run transactionally.
+//
+// Returns:
+//   - error: The error returned by fn, or one encountered beginning/committing the transaction.
+func (u *UnitOfWork) WithTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	sqlTx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			sqlTx.Rollback()
+			panic(p)
+		}
+		if err != nil {
+			sqlTx.Rollback()
+			return
+		}
+		err = sqlTx.Commit()
+	}()
+
+	err = fn(context.WithValue(ctx, ctxKey{}, sqlTx))
+	return err
+}
+
+// FromContext returns the *sql.Tx attached to ctx by WithTx, and whether
+// one was found.
+func FromContext(ctx context.Context) (*sql.Tx, bool) {
+	sqlTx, ok := ctx.Value(ctxKey{}).(*sql.Tx)
+	return sqlTx, ok
+}
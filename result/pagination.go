@@ -0,0 +1,19 @@
+package result
+
+type Paginated[T any] struct {
+	Items    []T  `json:"items"`
+	Page     int  `json:"page"`
+	PageSize int  `json:"pageSize"`
+	Total    int  `json:"total"`
+	HasNext  bool `json:"hasNext"`
+}
+
+func NewPaginated[T any](items []T, page, pageSize, total int) Paginated[T] {
+	return Paginated[T]{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+		HasNext:  page*pageSize < total,
+	}
+}
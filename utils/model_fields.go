@@ -1,12 +1,10 @@
 package utils
 
-import (
-	"reflect"
-	"sync"
-)
-
-var modelFieldCache = sync.Map{}
+import "reflect"
 
+// RegisterModels pre-computes and caches the Schema for each given model,
+// so the first query built for that type doesn't pay the reflection cost
+// on a request path.
 func RegisterModels(models ...interface{}) {
 	for _, model := range models {
 		registerModel(model)
@@ -15,27 +13,24 @@ func RegisterModels(models ...interface{}) {
 
 func registerModel(model interface{}) {
 	t := reflect.TypeOf(model)
-
-	fieldMap := make(map[string]string)
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		column := field.Name
-		if tag, ok := field.Tag.Lookup("db"); ok {
-			column = tag
-		}
-		fieldMap[field.Name] = column
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
 	}
-
-	modelFieldCache.Store(t.Name(), fieldMap)
+	schemaCache.Store(t, buildSchema(t))
 }
 
+// GetField returns the column name mapped to a struct field of model, as
+// resolved by its Schema.
 func GetField(model interface{}, fieldName string) string {
 	t := reflect.TypeOf(model)
-	if fields, ok := modelFieldCache.Load(t.Name()); ok {
-		if fieldMap, ok := fields.(map[string]string); ok {
-			if column, exists := fieldMap[fieldName]; exists {
-				return column
-			}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	schema := schemaOfType(t)
+	for _, col := range schema.Columns {
+		if col.FieldName == fieldName {
+			return col.ColumnName
 		}
 	}
 	panic("Field not found: " + fieldName)
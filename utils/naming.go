@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TableNamer derives a table name from an entity's Go type name.
+// QueryBuilder falls back to it whenever an entity has no explicit
+// `table:"..."` tag.
+type TableNamer interface {
+	TableName(typeName string) string
+}
+
+// defaultTableNamer converts a type name to snake_case and pluralises it,
+// e.g. "UserProfile" -> "user_profiles".
+type defaultTableNamer struct{}
+
+func (defaultTableNamer) TableName(typeName string) string {
+	return pluralize(toSnakeCase(typeName))
+}
+
+var activeTableNamer TableNamer = defaultTableNamer{}
+
+// SetTableNamer overrides the naming strategy used to derive table names
+// for entities that don't set an explicit `table:"..."` tag.
+func SetTableNamer(namer TableNamer) {
+	activeTableNamer = namer
+}
+
+// TableName derives the default SQL table name for a Go struct type name,
+// via the active TableNamer. Prefer SchemaOf where a Schema is already at
+// hand, since it also honours a per-entity `table:"..."` tag.
+func TableName(typeName string) string {
+	return activeTableNamer.TableName(typeName)
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func pluralize(s string) string {
+	switch {
+	case strings.HasSuffix(s, "y") && len(s) > 1 && !isVowel(s[len(s)-2]):
+		return s[:len(s)-1] + "ies"
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "z"),
+		strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	default:
+		return s + "s"
+	}
+}
+
+func isVowel(b byte) bool {
+	switch b {
+	case 'a', 'e', 'i', 'o', 'u':
+		return true
+	}
+	return false
+}
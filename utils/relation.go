@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RelationKind identifies how a Relation's target rows relate to the
+// entity declaring it.
+type RelationKind string
+
+const (
+	RelationHasOne    RelationKind = "has_one"
+	RelationHasMany   RelationKind = "has_many"
+	RelationBelongsTo RelationKind = "belongs_to"
+)
+
+// Relation describes a `rel:"..."` tagged field: a has_one/has_many field
+// whose target table holds the foreign key, or a belongs_to field whose
+// own table holds it.
+type Relation struct {
+	FieldName     string
+	Index         []int
+	Kind          RelationKind
+	ForeignKeyCol string       // the column carrying the foreign key
+	ReferenceCol  string       // the column it points at; defaults to "ID"
+	Target        reflect.Type // the related entity's struct type
+}
+
+// parseRelation reads a field's `rel:"kind,fk=col,ref=col"` tag. ok is
+// false when the field has no rel tag.
+func parseRelation(field reflect.StructField) (rel Relation, ok bool) {
+	tag, present := field.Tag.Lookup("rel")
+	if !present {
+		return Relation{}, false
+	}
+
+	parts := strings.Split(tag, ",")
+	rel = Relation{
+		FieldName:    field.Name,
+		Kind:         RelationKind(parts[0]),
+		ReferenceCol: "ID",
+		Target:       relationTarget(field.Type),
+	}
+
+	for _, part := range parts[1:] {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "fk":
+			rel.ForeignKeyCol = kv[1]
+		case "ref":
+			rel.ReferenceCol = kv[1]
+		}
+	}
+
+	return rel, true
+}
+
+// relationTarget unwraps a relation field's declared type (T, *T, or
+// []T) down to the related entity's struct type.
+func relationTarget(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,187 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Column describes a single mapped struct field: its Go field (identified
+// by a reflect.Value.FieldByIndex path, so embedded fields such as
+// entity.Entity's CreatedAt resolve correctly), its SQL column name, and
+// any `db`/`fk`/`index` tag metadata.
+type Column struct {
+	FieldName     string
+	ColumnName    string
+	Index         []int
+	PrimaryKey    bool
+	AutoIncrement bool
+	ForeignKey    string // "table.column", empty if none
+	IndexName     string // empty if the field has no index tag
+	Unique        bool
+}
+
+// Schema is the column metadata for an entity type, derived once from its
+// struct tags and cached for reuse by QueryBuilder and GenericRepository.
+type Schema struct {
+	TableName    string
+	Columns      []Column
+	PrimaryKeys  []Column
+	Relations    []Relation // fields declared via a `rel:"..."` tag
+	CreatedAtCol string     // empty if the entity has no CreatedAt field
+	UpdatedAtCol string     // empty if the entity has no UpdatedAt field
+	DeleteAtCol  string     // empty if the entity has no soft-delete column
+
+	explicitTable bool
+}
+
+// IsSoftDelete reports whether the entity has a soft-delete column, so
+// Delete should become an UPDATE rather than a DELETE.
+func (s Schema) IsSoftDelete() bool { return s.DeleteAtCol != "" }
+
+// Column looks up a mapped column by its SQL column name.
+func (s Schema) Column(name string) (Column, bool) {
+	for _, col := range s.Columns {
+		if col.ColumnName == name {
+			return col, true
+		}
+	}
+	return Column{}, false
+}
+
+// Relation looks up a declared relation by its Go field name.
+func (s Schema) Relation(name string) (Relation, bool) {
+	for _, rel := range s.Relations {
+		if rel.FieldName == name {
+			return rel, true
+		}
+	}
+	return Relation{}, false
+}
+
+// SchemaForType returns the cached Schema for a struct type known only at
+// runtime, such as a relation's target entity. Prefer SchemaOf when the
+// type is known at compile time.
+func SchemaForType(t reflect.Type) Schema {
+	return schemaOfType(t)
+}
+
+var schemaCache = sync.Map{}
+
+// SchemaOf returns the cached Schema for entity type T, building and
+// caching it on first use.
+func SchemaOf[T any]() Schema {
+	var zero T
+	return schemaOfType(reflect.TypeOf(zero))
+}
+
+func schemaOfType(t reflect.Type) Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if cached, ok := schemaCache.Load(t); ok {
+		return cached.(Schema)
+	}
+
+	s := buildSchema(t)
+	schemaCache.Store(t, s)
+	return s
+}
+
+func buildSchema(t reflect.Type) Schema {
+	s := Schema{TableName: activeTableNamer.TableName(t.Name())}
+	collectColumns(t, nil, &s)
+
+	if len(s.PrimaryKeys) == 0 {
+		for i := range s.Columns {
+			if s.Columns[i].FieldName == "ID" {
+				s.Columns[i].PrimaryKey = true
+				s.PrimaryKeys = append(s.PrimaryKeys, s.Columns[i])
+				break
+			}
+		}
+	}
+
+	return s
+}
+
+func collectColumns(t reflect.Type, prefix []int, s *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		index := append(append([]int{}, prefix...), i)
+
+		if !s.explicitTable {
+			if name, ok := field.Tag.Lookup("table"); ok {
+				s.TableName = name
+				s.explicitTable = true
+			}
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collectColumns(field.Type, index, s)
+			continue
+		}
+
+		if rel, ok := parseRelation(field); ok {
+			rel.Index = index
+			s.Relations = append(s.Relations, rel)
+			continue
+		}
+
+		col := parseColumn(field)
+		col.Index = index
+		s.Columns = append(s.Columns, col)
+
+		if col.PrimaryKey {
+			s.PrimaryKeys = append(s.PrimaryKeys, col)
+		}
+
+		switch field.Name {
+		case "CreatedAt":
+			s.CreatedAtCol = col.ColumnName
+		case "UpdatedAt":
+			s.UpdatedAtCol = col.ColumnName
+		case "DeleteAt":
+			s.DeleteAtCol = col.ColumnName
+		}
+	}
+}
+
+// parseColumn reads a field's `db:"col,pk,autoincrement"`,
+// `fk:"table.column"`, and `index:"name,unique"` tags into a Column. A
+// field without a `db` tag maps to a column of the same name.
+func parseColumn(field reflect.StructField) Column {
+	col := Column{FieldName: field.Name, ColumnName: field.Name}
+
+	if tag, ok := field.Tag.Lookup("db"); ok {
+		parts := strings.Split(tag, ",")
+		if parts[0] != "" {
+			col.ColumnName = parts[0]
+		}
+		for _, flag := range parts[1:] {
+			switch flag {
+			case "pk":
+				col.PrimaryKey = true
+			case "autoincrement":
+				col.AutoIncrement = true
+			}
+		}
+	}
+
+	if fk, ok := field.Tag.Lookup("fk"); ok {
+		col.ForeignKey = fk
+	}
+
+	if idx, ok := field.Tag.Lookup("index"); ok {
+		parts := strings.Split(idx, ",")
+		col.IndexName = parts[0]
+		for _, flag := range parts[1:] {
+			if flag == "unique" {
+				col.Unique = true
+			}
+		}
+	}
+
+	return col
+}